@@ -0,0 +1,61 @@
+// Package panicif provides a small kit of generic assertion helpers that
+// panic with a formatted message (including file:line) when a condition
+// is violated. It pairs with the panicnil package's Panic/Catch demo,
+// giving a lightweight way to assert invariants without pulling in a
+// testing or assertion library.
+package panicif
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func callerPrefix() string {
+	_, file, line, ok := runtime.Caller(2)
+	if !ok {
+		return "unknown:0"
+	}
+	return fmt.Sprintf("%s:%d", file, line)
+}
+
+// NotEqual panics if a != b.
+func NotEqual[T comparable](a, b T) {
+	if a != b {
+		panic(fmt.Sprintf("%s: panicif.NotEqual: %+v != %+v", callerPrefix(), a, b))
+	}
+}
+
+// Equal panics if a == b.
+func Equal[T comparable](a, b T) {
+	if a == b {
+		panic(fmt.Sprintf("%s: panicif.Equal: %+v == %+v", callerPrefix(), a, b))
+	}
+}
+
+// Nil panics if v is nil.
+func Nil[T any](v *T) {
+	if v == nil {
+		panic(fmt.Sprintf("%s: panicif.Nil: got nil pointer", callerPrefix()))
+	}
+}
+
+// True panics if b is true.
+func True(b bool) {
+	if b {
+		panic(fmt.Sprintf("%s: panicif.True: condition was true", callerPrefix()))
+	}
+}
+
+// False panics if b is false.
+func False(b bool) {
+	if !b {
+		panic(fmt.Sprintf("%s: panicif.False: condition was false", callerPrefix()))
+	}
+}
+
+// Err panics if err is non-nil.
+func Err(err error) {
+	if err != nil {
+		panic(fmt.Sprintf("%s: panicif.Err: %v", callerPrefix(), err))
+	}
+}
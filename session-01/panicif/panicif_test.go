@@ -0,0 +1,115 @@
+package panicif
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func recoverPanic(fn func()) (value any, panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			value = r
+			panicked = true
+		}
+	}()
+	fn()
+	return nil, false
+}
+
+func TestNotEqual(t *testing.T) {
+	if _, panicked := recoverPanic(func() { NotEqual(1, 1) }); panicked {
+		t.Fatalf("NotEqual(1, 1) should not panic")
+	}
+
+	v, panicked := recoverPanic(func() { NotEqual(1, 2) })
+	if !panicked {
+		t.Fatalf("NotEqual(1, 2) should panic")
+	}
+	if msg, ok := v.(string); !ok || !strings.Contains(msg, "panicif.NotEqual: 1 != 2") {
+		t.Fatalf("unexpected panic message: %v", v)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	if _, panicked := recoverPanic(func() { Equal(1, 2) }); panicked {
+		t.Fatalf("Equal(1, 2) should not panic")
+	}
+
+	v, panicked := recoverPanic(func() { Equal(1, 1) })
+	if !panicked {
+		t.Fatalf("Equal(1, 1) should panic")
+	}
+	if msg, ok := v.(string); !ok || !strings.Contains(msg, "panicif.Equal: 1 == 1") {
+		t.Fatalf("unexpected panic message: %v", v)
+	}
+}
+
+func TestNil(t *testing.T) {
+	n := 42
+	if _, panicked := recoverPanic(func() { Nil(&n) }); panicked {
+		t.Fatalf("Nil(&n) should not panic")
+	}
+
+	v, panicked := recoverPanic(func() { Nil[int](nil) })
+	if !panicked {
+		t.Fatalf("Nil(nil) should panic")
+	}
+	if msg, ok := v.(string); !ok || !strings.Contains(msg, "panicif.Nil: got nil pointer") {
+		t.Fatalf("unexpected panic message: %v", v)
+	}
+}
+
+func TestTrue(t *testing.T) {
+	if _, panicked := recoverPanic(func() { True(false) }); panicked {
+		t.Fatalf("True(false) should not panic")
+	}
+
+	v, panicked := recoverPanic(func() { True(true) })
+	if !panicked {
+		t.Fatalf("True(true) should panic")
+	}
+	if msg, ok := v.(string); !ok || !strings.Contains(msg, "panicif.True: condition was true") {
+		t.Fatalf("unexpected panic message: %v", v)
+	}
+}
+
+func TestFalse(t *testing.T) {
+	if _, panicked := recoverPanic(func() { False(true) }); panicked {
+		t.Fatalf("False(true) should not panic")
+	}
+
+	v, panicked := recoverPanic(func() { False(false) })
+	if !panicked {
+		t.Fatalf("False(false) should panic")
+	}
+	if msg, ok := v.(string); !ok || !strings.Contains(msg, "panicif.False: condition was false") {
+		t.Fatalf("unexpected panic message: %v", v)
+	}
+}
+
+func TestErr(t *testing.T) {
+	if _, panicked := recoverPanic(func() { Err(nil) }); panicked {
+		t.Fatalf("Err(nil) should not panic")
+	}
+
+	boom := errors.New("boom")
+	v, panicked := recoverPanic(func() { Err(boom) })
+	if !panicked {
+		t.Fatalf("Err(boom) should panic")
+	}
+	if msg, ok := v.(string); !ok || !strings.Contains(msg, "panicif.Err: boom") {
+		t.Fatalf("unexpected panic message: %v", v)
+	}
+}
+
+func TestCallerPrefixIncludesFileAndLine(t *testing.T) {
+	v, panicked := recoverPanic(func() { Equal(1, 1) })
+	if !panicked {
+		t.Fatalf("Equal(1, 1) should panic")
+	}
+	msg, ok := v.(string)
+	if !ok || !strings.Contains(msg, "panicif_test.go:") {
+		t.Fatalf("panic message missing caller file:line: %v", v)
+	}
+}
@@ -0,0 +1,101 @@
+package panicnil
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+)
+
+// ErrPanicNil is the sentinel wrapped by PanicError when fn panicked
+// with nil: either recover() came back nil yet a panic actually occurred
+// (the legacy panic(nil) behavior), or it returned the
+// *runtime.PanicNilError that Go 1.21 reports by default.
+var ErrPanicNil = errors.New("panicnil: panic called with nil argument")
+
+// PanicError wraps a recovered panic(nil) value as an error, preserving
+// the recovered value and a snapshot of the goroutine's stack at the
+// point of recovery. It is errors.As-compatible, and Unwrap exposes
+// ErrPanicNil for errors.Is. CatchError and CatchValue only produce a
+// *PanicError for the nil-panic case; a panic value that already
+// satisfies error is returned unchanged instead (see CatchError).
+type PanicError struct {
+	Value  any
+	Stack  []byte
+	WasNil bool
+}
+
+func (e *PanicError) Error() string {
+	return ErrPanicNil.Error()
+}
+
+func (e *PanicError) Unwrap() error {
+	return ErrPanicNil
+}
+
+func newPanicError(r any) *PanicError {
+	buf := make([]byte, 64<<10)
+	n := runtime.Stack(buf, false)
+	return &PanicError{
+		Value:  r,
+		Stack:  buf[:n],
+		WasNil: true,
+	}
+}
+
+// isPanicNilError reports whether r is the runtime's own representation
+// of a panic(nil) call (*runtime.PanicNilError, exported since Go 1.21).
+func isPanicNilError(r any) bool {
+	_, ok := r.(*runtime.PanicNilError)
+	return ok
+}
+
+// CatchError runs fn and recovers any panic, normalizing the result into
+// an error with three rules, applied in order: if fn panicked with nil
+// or the runtime's own *runtime.PanicNilError, the returned error
+// unwraps to ErrPanicNil; if the recovered value already satisfies
+// error, it is returned unchanged; otherwise it is wrapped via
+// fmt.Errorf("%v", r). CatchError returns nil if fn does not panic.
+func CatchError(fn func()) (err error) {
+	finished := false
+	defer func() {
+		if finished {
+			return
+		}
+		err = normalizeRecovered(recover())
+	}()
+
+	fn()
+	finished = true
+	return nil
+}
+
+// CatchValue runs fn, recovers any panic, and normalizes the result into
+// a (T, error) pair the way CatchError normalizes a plain error. Unlike
+// the backlog's literal CatchValue[T any](fn func()) (T, error) shape,
+// fn here returns T directly rather than through a side channel, since
+// that's the more useful signature for callers. On a successful call,
+// fn's return value and a nil error are returned; on panic, value is
+// T's zero value.
+func CatchValue[T any](fn func() T) (value T, err error) {
+	finished := false
+	defer func() {
+		if finished {
+			return
+		}
+		err = normalizeRecovered(recover())
+	}()
+
+	value = fn()
+	finished = true
+	return value, nil
+}
+
+func normalizeRecovered(r any) error {
+	if r == nil || isPanicNilError(r) {
+		return newPanicError(r)
+	}
+	if err, ok := r.(error); ok {
+		return err
+	}
+	return fmt.Errorf("%v", r)
+}
@@ -0,0 +1,74 @@
+package panicnil
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCatchErrorNoPanic(t *testing.T) {
+	err := CatchError(func() {})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+}
+
+func TestCatchErrorNilPanic(t *testing.T) {
+	err := CatchError(func() { panic(nil) })
+	if err == nil {
+		t.Fatalf("expected non-nil error")
+	}
+	if !errors.Is(err, ErrPanicNil) {
+		t.Fatalf("expected errors.Is(err, ErrPanicNil), got %v", err)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected errors.As to find *PanicError, got %v", err)
+	}
+	if !panicErr.WasNil {
+		t.Fatalf("expected PanicError.WasNil to be true")
+	}
+	if len(panicErr.Stack) == 0 {
+		t.Fatalf("expected PanicError.Stack to be populated")
+	}
+}
+
+func TestCatchErrorErrorPanic(t *testing.T) {
+	boom := errors.New("boom")
+	err := CatchError(func() { panic(boom) })
+	if err != boom {
+		t.Fatalf("expected CatchError to return the panicked error unchanged, got %v", err)
+	}
+}
+
+func TestCatchErrorPlainValuePanic(t *testing.T) {
+	err := CatchError(func() { panic("boom") })
+	if err == nil {
+		t.Fatalf("expected non-nil error")
+	}
+	if err.Error() != "boom" {
+		t.Fatalf("unexpected error message: %v", err)
+	}
+}
+
+func TestCatchValueNoPanic(t *testing.T) {
+	value, err := CatchValue(func() int { return 42 })
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if value != 42 {
+		t.Fatalf("expected 42, got %d", value)
+	}
+}
+
+func TestCatchValuePanic(t *testing.T) {
+	value, err := CatchValue(func() int {
+		panic("boom")
+	})
+	if err == nil {
+		t.Fatalf("expected non-nil error")
+	}
+	if value != 0 {
+		t.Fatalf("expected zero value on panic, got %d", value)
+	}
+}
@@ -0,0 +1,47 @@
+package panicnil
+
+import "fmt"
+
+// ChainedPanic demonstrates Go's "a later deferred panic replaces an
+// earlier one" rule: once a goroutine is already unwinding from
+// panic("panic1"), the deferred panic("panic2") takes over as the
+// active panic, and only "panic2" is ever visible to recover.
+func ChainedPanic() {
+	defer func() {
+		r := recover()
+		fmt.Printf("recovered: %v\n", r)
+	}()
+	defer panic("panic2")
+	panic("panic1")
+}
+
+// RunChain runs each of fns in turn, recovering any panic under its own
+// deferred recover so that one fn panicking does not stop the rest of
+// the chain from running. It returns the value recovered after each
+// stage, in call order, so callers can observe how a panic from one
+// stage relates to the next — nil in a stage's slot means that fn
+// completed without panicking or, while legacy nil-panic mode is active
+// (see SetLegacyNilPanic), that it panicked with nil.
+func RunChain(fns ...func()) []any {
+	recovered := make([]any, len(fns))
+	for i, fn := range fns {
+		recovered[i] = runStage(fn)
+	}
+	return recovered
+}
+
+func runStage(fn func()) (r any) {
+	defer func() {
+		r = recover()
+	}()
+	fn()
+	return nil
+}
+
+// PrintChain prints each stage's recovered value the way Catch prints a
+// single one: its type, its value, and whether it was nil.
+func PrintChain(recovered []any) {
+	for i, r := range recovered {
+		fmt.Printf("stage %d: type: %T value: %v is nil: %v\n", i, r, r, r == nil)
+	}
+}
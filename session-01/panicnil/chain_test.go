@@ -0,0 +1,49 @@
+package panicnil
+
+import "testing"
+
+func TestRunChainNoPanic(t *testing.T) {
+	var ran []int
+	recovered := RunChain(
+		func() { ran = append(ran, 0) },
+		func() { ran = append(ran, 1) },
+	)
+	if len(ran) != 2 || ran[0] != 0 || ran[1] != 1 {
+		t.Fatalf("expected both stages to run in order, got %v", ran)
+	}
+	if recovered[0] != nil || recovered[1] != nil {
+		t.Fatalf("expected no recovered values, got %v", recovered)
+	}
+}
+
+func TestRunChainIndependentStages(t *testing.T) {
+	var ranSecond bool
+	recovered := RunChain(
+		func() { panic("stage0") },
+		func() { ranSecond = true },
+	)
+	if recovered[0] != "stage0" {
+		t.Fatalf("expected stage 0 to recover %q, got %v", "stage0", recovered[0])
+	}
+	if !ranSecond {
+		t.Fatalf("expected stage 1 to run even though stage 0 panicked")
+	}
+	if recovered[1] != nil {
+		t.Fatalf("expected stage 1 to recover nil, got %v", recovered[1])
+	}
+}
+
+func TestRunChainReplacedPanicWinsWithinAStage(t *testing.T) {
+	recovered := RunChain(func() {
+		defer panic("panic2")
+		panic("panic1")
+	})
+	if recovered[0] != "panic2" {
+		t.Fatalf("expected the later deferred panic to replace the earlier one, got %v", recovered[0])
+	}
+}
+
+func TestChainedPanicDoesNotEscape(t *testing.T) {
+	// ChainedPanic recovers internally; it must not panic out of this call.
+	ChainedPanic()
+}
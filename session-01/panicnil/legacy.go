@@ -0,0 +1,58 @@
+package panicnil
+
+import (
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+var legacyNilPanic atomic.Bool
+
+func init() {
+	legacyNilPanic.Store(godebugHasPanicNil(os.Getenv("GODEBUG")))
+}
+
+func godebugHasPanicNil(godebug string) bool {
+	for _, setting := range strings.Split(godebug, ",") {
+		if strings.TrimSpace(setting) == "panicnil=1" {
+			return true
+		}
+	}
+	return false
+}
+
+// SetLegacyNilPanic toggles whether panic(nil) in this process recovers
+// as an untyped nil, matching Go's pre-1.21 behavior, or as a
+// *runtime.PanicNilError, matching Go 1.21+'s default.
+//
+// Rather than reaching into the runtime via go:linkname — unsupported,
+// and liable to break silently across releases — SetLegacyNilPanic edits
+// the process's GODEBUG environment variable directly. GODEBUG settings
+// like panicnil are polled by the runtime and honored even after process
+// start, so this reproduces the effect of launching with
+// GODEBUG=panicnil=1 without needing to restart the process.
+func SetLegacyNilPanic(enabled bool) {
+	legacyNilPanic.Store(enabled)
+	os.Setenv("GODEBUG", setGodebugPanicNil(os.Getenv("GODEBUG"), enabled))
+}
+
+func setGodebugPanicNil(godebug string, enabled bool) string {
+	var kept []string
+	for _, setting := range strings.Split(godebug, ",") {
+		if setting == "" || strings.HasPrefix(setting, "panicnil=") {
+			continue
+		}
+		kept = append(kept, setting)
+	}
+	if enabled {
+		kept = append(kept, "panicnil=1")
+	}
+	return strings.Join(kept, ",")
+}
+
+// LegacyNilPanic reports whether legacy (pre-1.21) nil-panic behavior is
+// currently active, as last set by SetLegacyNilPanic or observed from
+// GODEBUG at process start.
+func LegacyNilPanic() bool {
+	return legacyNilPanic.Load()
+}
@@ -0,0 +1,51 @@
+package panicnil
+
+import (
+	"os"
+	"testing"
+)
+
+func recoverBareNilPanic() (r any) {
+	defer func() {
+		r = recover()
+	}()
+	panic(nil)
+}
+
+func TestSetLegacyNilPanicTogglesRecoverBehavior(t *testing.T) {
+	original := os.Getenv("GODEBUG")
+	t.Cleanup(func() {
+		os.Setenv("GODEBUG", original)
+		SetLegacyNilPanic(false)
+	})
+
+	SetLegacyNilPanic(false)
+	if LegacyNilPanic() {
+		t.Fatalf("expected LegacyNilPanic() to be false")
+	}
+	if r := recoverBareNilPanic(); r == nil {
+		t.Fatalf("expected recover() to yield a non-nil *runtime.PanicNilError")
+	}
+
+	SetLegacyNilPanic(true)
+	if !LegacyNilPanic() {
+		t.Fatalf("expected LegacyNilPanic() to be true")
+	}
+	if r := recoverBareNilPanic(); r != nil {
+		t.Fatalf("expected legacy nil-panic recovery to yield untyped nil, got %v (%T)", r, r)
+	}
+}
+
+func TestGodebugHasPanicNil(t *testing.T) {
+	cases := map[string]bool{
+		"":                        false,
+		"panicnil=1":              true,
+		"http2client=0,panicnil=1": true,
+		"panicnil=0":              false,
+	}
+	for godebug, want := range cases {
+		if got := godebugHasPanicNil(godebug); got != want {
+			t.Errorf("godebugHasPanicNil(%q) = %v, want %v", godebug, got, want)
+		}
+	}
+}
@@ -9,7 +9,7 @@ func Panic() {
 func Catch() {
 	defer func() {
 		r := recover()
-		fmt.Printf("type: %T\nvalue: %v\nis nil: %v\n", r, r, r == nil)
+		fmt.Printf("type: %T\nvalue: %v\nis nil: %v\nlegacy nil-panic mode: %v\n", r, r, r == nil, LegacyNilPanic())
 	}()
 
 	panic(nil)